@@ -3,8 +3,10 @@
 package main
 
 import (
-	"fmt"
-	"net/http"
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
 	"template/infrastructure/logger"
 	http_interface "template/interfaces/http"
 )
@@ -17,18 +19,37 @@ import (
 // @port 8080
 // @ast-trackable true
 func main() {
-	appLogger := logger.NewPrettyLogger("APP", logger.LevelDebug, true)
+	appLogger := newLoggerFromEnv()
 
 	router := http_interface.NewRouter(appLogger)
 
-	appLogger.Info("SERVER_START", map[string]interface{}{
-		"port": 8080,
-	}, "Starting server")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	fmt.Println("Server starting on :8080")
-	if err := http.ListenAndServe(":8080", router); err != nil {
+	if err := Run(ctx, ConfigFromEnv(), router, appLogger); err != nil {
 		appLogger.Critical("SERVER_ERROR", map[string]interface{}{
 			"error": err,
 		}, "Failed to start server")
 	}
 }
+
+// newLoggerFromEnv builds the application logger, selecting its output
+// format from the LOG_FORMAT env var ("pretty", "logfmt", or "json";
+// defaults to "pretty")
+// @function newLoggerFromEnv
+// @package main
+// @returns logger.Logger - Configured logger instance
+// @ast-trackable true
+func newLoggerFromEnv() logger.Logger {
+	var formatter logger.Formatter
+	switch os.Getenv("LOG_FORMAT") {
+	case "logfmt":
+		formatter = logger.LogfmtFormatter{}
+	case "json":
+		formatter = logger.JSONFormatter{}
+	default:
+		formatter = logger.ColorFormatter{}
+	}
+
+	return logger.NewPrettyLoggerWithFormatter("APP", logger.LevelDebug, formatter, os.Stdout)
+}
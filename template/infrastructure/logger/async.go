@@ -0,0 +1,203 @@
+// FILE: template/infrastructure/logger/async.go
+
+package logger
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// DropPolicy controls what AsyncWriter does when its internal buffer is full
+// @type DropPolicy
+// @package template/infrastructure/logger
+// @primitive int
+// @enum true
+// @ast-trackable true
+type DropPolicy int
+
+// Drop policy constants for AsyncWriter
+// @const DropOldest
+// @const Block
+// @const DropNewest
+// @package template/infrastructure/logger
+// @type DropPolicy
+// @enum-values true
+// @ast-trackable true
+const (
+	// DropOldest evicts the oldest buffered line to make room for the new one
+	DropOldest DropPolicy = iota
+	// Block waits for buffer space, applying backpressure to the caller
+	Block
+	// DropNewest discards the incoming line when the buffer is full
+	DropNewest
+)
+
+// ErrAsyncWriterClosed is returned by a Block-policy Write that is waiting
+// for buffer space once Close has been called. Write does not otherwise check
+// for Close: under DropOldest and DropNewest, or under Block while the buffer
+// has room, a Write after Close can report success and its line is never written
+// @const ErrAsyncWriterClosed
+// @package template/infrastructure/logger
+// @ast-trackable true
+var ErrAsyncWriterClosed = errors.New("logger: async writer closed")
+
+// AsyncWriter buffers formatted log lines in a channel and writes them to an
+// underlying io.Writer from a single background goroutine, decoupling log
+// callers from slow or blocking sinks
+// @struct AsyncWriter
+// @package template/infrastructure/logger
+// @fields out,queue,policy,done,flushCh,wg,closeOnce
+// @thread-safe true
+// @ast-trackable true
+type AsyncWriter struct {
+	out    io.Writer
+	queue  chan []byte
+	policy DropPolicy
+
+	done      chan struct{}
+	flushCh   chan chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewAsyncWriter creates an AsyncWriter with the given buffer capacity (in
+// lines) and drop policy, and starts its background writer goroutine
+// @constructor AsyncWriter
+// @package template/infrastructure/logger
+// @function NewAsyncWriter
+// @params out io.Writer - Underlying writer lines are flushed to
+// @params bufferSize int - Number of buffered lines before policy kicks in
+// @params policy DropPolicy - Behavior when the buffer is full
+// @returns *AsyncWriter - Running async writer
+// @usage w := logger.NewAsyncWriter(os.Stdout, 1024, logger.DropOldest)
+// @ast-trackable true
+// @factory-function true
+func NewAsyncWriter(out io.Writer, bufferSize int, policy DropPolicy) *AsyncWriter {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+
+	w := &AsyncWriter{
+		out:     out,
+		queue:   make(chan []byte, bufferSize),
+		policy:  policy,
+		done:    make(chan struct{}),
+		flushCh: make(chan chan struct{}),
+	}
+
+	w.wg.Add(1)
+	go w.run()
+
+	return w
+}
+
+// Write enqueues p (copied) for the background goroutine to flush, applying
+// the configured DropPolicy if the buffer is full
+// @method Write
+// @receiver *AsyncWriter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (w *AsyncWriter) Write(p []byte) (int, error) {
+	line := append([]byte(nil), p...)
+
+	switch w.policy {
+	case Block:
+		select {
+		case w.queue <- line:
+		case <-w.done:
+			return 0, ErrAsyncWriterClosed
+		}
+	case DropNewest:
+		select {
+		case w.queue <- line:
+		default:
+		}
+	default: // DropOldest
+		for {
+			select {
+			case w.queue <- line:
+				return len(p), nil
+			default:
+				select {
+				case <-w.queue:
+				default:
+				}
+			}
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush blocks until every line currently buffered has been written
+// @method Flush
+// @receiver *AsyncWriter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (w *AsyncWriter) Flush() {
+	reply := make(chan struct{})
+	select {
+	case w.flushCh <- reply:
+		<-reply
+	case <-w.done:
+	}
+}
+
+// Close stops the background goroutine after draining any buffered lines,
+// then closes the underlying writer if it implements io.Closer
+// @method Close
+// @receiver *AsyncWriter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (w *AsyncWriter) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		w.wg.Wait()
+	})
+
+	if c, ok := w.out.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// run is the AsyncWriter's background goroutine loop
+// @method run
+// @receiver *AsyncWriter
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func (w *AsyncWriter) run() {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case line := <-w.queue:
+			w.out.Write(line)
+		case reply := <-w.flushCh:
+			w.drain()
+			close(reply)
+		case <-w.done:
+			w.drain()
+			return
+		}
+	}
+}
+
+// drain flushes every line currently sitting in the queue without blocking
+// @method drain
+// @receiver *AsyncWriter
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func (w *AsyncWriter) drain() {
+	for {
+		select {
+		case line := <-w.queue:
+			w.out.Write(line)
+		default:
+			return
+		}
+	}
+}
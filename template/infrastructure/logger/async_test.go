@@ -0,0 +1,138 @@
+// FILE: template/infrastructure/logger/async_test.go
+
+package logger
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingWriter is an io.Writer that blocks every Write until release is
+// closed, closing started the first time Write is entered. A test can wait
+// on started to know the AsyncWriter's background goroutine has dequeued and
+// is holding a line, which pins the internal buffer at a known fill level
+type blockingWriter struct {
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+
+	mu    sync.Mutex
+	lines [][]byte
+}
+
+func newBlockingWriter() *blockingWriter {
+	return &blockingWriter{started: make(chan struct{}), release: make(chan struct{})}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.started) })
+	<-w.release
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lines = append(w.lines, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func (w *blockingWriter) Lines() [][]byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	out := make([][]byte, len(w.lines))
+	copy(out, w.lines)
+	return out
+}
+
+func TestAsyncWriterDropOldestEvictsOldestBufferedLine(t *testing.T) {
+	out := newBlockingWriter()
+	w := NewAsyncWriter(out, 1, DropOldest)
+
+	w.Write([]byte("first"))
+	<-out.started // background goroutine now holds "first", buffer is free
+
+	w.Write([]byte("second")) // fills the size-1 buffer
+	w.Write([]byte("third"))  // buffer full: evicts "second", keeps "third"
+
+	close(out.release)
+	w.Close()
+
+	got := out.Lines()
+	if len(got) != 2 {
+		t.Fatalf("got %d lines, want 2: %v", len(got), got)
+	}
+	if string(got[0]) != "first" || string(got[1]) != "third" {
+		t.Fatalf("got lines %q, want [first third]", got)
+	}
+}
+
+func TestAsyncWriterDropNewestDiscardsIncomingLine(t *testing.T) {
+	out := newBlockingWriter()
+	w := NewAsyncWriter(out, 1, DropNewest)
+
+	w.Write([]byte("first"))
+	<-out.started // background goroutine now holds "first", buffer is free
+
+	w.Write([]byte("second")) // fills the size-1 buffer
+	w.Write([]byte("third"))  // buffer full: discarded
+
+	close(out.release)
+	w.Close()
+
+	got := out.Lines()
+	if len(got) != 2 {
+		t.Fatalf("got %d lines, want 2: %v", len(got), got)
+	}
+	if string(got[0]) != "first" || string(got[1]) != "second" {
+		t.Fatalf("got lines %q, want [first second]", got)
+	}
+}
+
+func TestAsyncWriterBlockAppliesBackpressure(t *testing.T) {
+	out := newBlockingWriter()
+	w := NewAsyncWriter(out, 1, Block)
+
+	w.Write([]byte("first"))
+	<-out.started // background goroutine now holds "first", buffer is free
+
+	w.Write([]byte("second")) // fills the size-1 buffer
+
+	wroteThird := make(chan struct{})
+	go func() {
+		w.Write([]byte("third"))
+		close(wroteThird)
+	}()
+
+	select {
+	case <-wroteThird:
+		t.Fatal("Write returned before buffer space was freed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	close(out.release)
+
+	select {
+	case <-wroteThird:
+	case <-time.After(time.Second):
+		t.Fatal("Write never unblocked after buffer space freed")
+	}
+
+	w.Close()
+
+	got := out.Lines()
+	if len(got) != 3 {
+		t.Fatalf("got %d lines, want 3: %v", len(got), got)
+	}
+}
+
+func TestAsyncWriterWriteAfterCloseReturnsClosedError(t *testing.T) {
+	w := NewAsyncWriter(io.Discard, 1, Block)
+	w.Close()
+
+	// Nothing drains w.queue once the background goroutine has exited, so
+	// occupying its only slot forces Write's select to resolve via <-w.done
+	w.queue <- []byte("filler")
+
+	if _, err := w.Write([]byte("late")); err != ErrAsyncWriterClosed {
+		t.Fatalf("got err %v, want ErrAsyncWriterClosed", err)
+	}
+}
@@ -0,0 +1,158 @@
+// FILE: template/infrastructure/logger/context.go
+
+package logger
+
+import (
+	"context"
+	"sync"
+)
+
+// contextKeyBinding associates a context.Context key with the log field name
+// it should be extracted into by WithContext
+// @struct contextKeyBinding
+// @package template/infrastructure/logger
+// @fields key,logField
+// @private true
+// @ast-trackable true
+type contextKeyBinding struct {
+	key      any
+	logField string
+}
+
+var (
+	contextKeyRegistry   []contextKeyBinding
+	contextKeyRegistryMu sync.Mutex
+)
+
+// RegisterContextKey registers a context.Context key that every logger's
+// WithContext should auto-extract into the log field named logField. It is
+// typically called once by middleware during application startup
+// @function RegisterContextKey
+// @package template/infrastructure/logger
+// @params key any - context.Context key to look up
+// @params logField string - Field name to store the extracted value under
+// @usage logger.RegisterContextKey(ctxutil.RequestIDKey, "request_id")
+// @ast-trackable true
+func RegisterContextKey(key any, logField string) {
+	contextKeyRegistryMu.Lock()
+	defer contextKeyRegistryMu.Unlock()
+	contextKeyRegistry = append(contextKeyRegistry, contextKeyBinding{key: key, logField: logField})
+}
+
+// registeredContextKeys returns a snapshot of the current registry
+// @function registeredContextKeys
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func registeredContextKeys() []contextKeyBinding {
+	contextKeyRegistryMu.Lock()
+	defer contextKeyRegistryMu.Unlock()
+	out := make([]contextKeyBinding, len(contextKeyRegistry))
+	copy(out, contextKeyRegistry)
+	return out
+}
+
+// With returns a cheap child logger whose presetFields are merged into every
+// subsequent log call made through it, in addition to the receiver's own
+// @method With
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @params fields map[string]interface{} - Fields to preset on the child logger
+// @returns *PrettyLogger - Child logger sharing the receiver's configuration
+// @usage reqLogger := appLogger.With(map[string]interface{}{"request_id": id})
+// @ast-trackable true
+func (l *PrettyLogger) With(fields map[string]interface{}) *PrettyLogger {
+	preset := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		preset[k] = v
+	}
+	return &PrettyLogger{parent: l, presetFields: preset}
+}
+
+// WithContext returns a child logger whose presetFields are populated from
+// any context keys registered via RegisterContextKey that are present on ctx
+// @method WithContext
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @params ctx context.Context - Context to extract registered keys from
+// @returns *PrettyLogger - Child logger carrying the extracted fields
+// @usage reqLogger := appLogger.WithContext(r.Context())
+// @ast-trackable true
+func (l *PrettyLogger) WithContext(ctx context.Context) *PrettyLogger {
+	bindings := registeredContextKeys()
+	fields := make(map[string]interface{}, len(bindings))
+	keys := make([]any, 0, len(bindings))
+
+	for _, b := range bindings {
+		if v := ctx.Value(b.key); v != nil {
+			fields[b.logField] = v
+			keys = append(keys, b.key)
+		}
+	}
+
+	return &PrettyLogger{parent: l, presetFields: fields, ctxKeys: keys}
+}
+
+// DebugCtx logs at LevelDebug after merging ctx's registered context keys
+// @method DebugCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) DebugCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Debug(eventCode, fields, message)
+}
+
+// InfoCtx logs at LevelInfo after merging ctx's registered context keys
+// @method InfoCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) InfoCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Info(eventCode, fields, message)
+}
+
+// SuccessCtx logs at LevelSuccess after merging ctx's registered context keys
+// @method SuccessCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) SuccessCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Success(eventCode, fields, message)
+}
+
+// WarningCtx logs at LevelWarning after merging ctx's registered context keys
+// @method WarningCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) WarningCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Warning(eventCode, fields, message)
+}
+
+// ErrorCtx logs at LevelError after merging ctx's registered context keys
+// @method ErrorCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) ErrorCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Error(eventCode, fields, message)
+}
+
+// CriticalCtx logs at LevelCritical after merging ctx's registered context keys
+// @method CriticalCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) CriticalCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Critical(eventCode, fields, message)
+}
+
+// FatalCtx logs at LevelCritical after merging ctx's registered context keys,
+// then terminates the process via os.Exit(1)
+// @method FatalCtx
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (l *PrettyLogger) FatalCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	l.WithContext(ctx).Fatal(eventCode, fields, message)
+}
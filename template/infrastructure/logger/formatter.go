@@ -0,0 +1,284 @@
+// FILE: template/infrastructure/logger/formatter.go
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// Entry captures a single log event in a renderer-agnostic shape
+// @struct Entry
+// @package template/infrastructure/logger
+// @fields Timestamp,Level,Service,EventCode,Caller,Fields,Message
+// @ast-trackable true
+type Entry struct {
+	Timestamp time.Time
+	Level     LogLevel
+	Service   string
+	EventCode string
+	Caller    string
+	Fields    map[string]interface{}
+	Message   string
+}
+
+// Formatter renders a log Entry into the bytes written for one log line
+// @interface Formatter
+// @package template/infrastructure/logger
+// @method Format(e Entry) []byte
+// @ast-trackable true
+type Formatter interface {
+	Format(e Entry) []byte
+}
+
+// TextFormatter renders entries as plain, uncolored text
+// @struct TextFormatter
+// @package template/infrastructure/logger
+// @implements Formatter
+// @ast-trackable true
+type TextFormatter struct{}
+
+// Format renders e as plain text, matching the original PrettyLogger output
+// @method Format
+// @receiver TextFormatter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (TextFormatter) Format(e Entry) []byte {
+	timestamp := e.Timestamp.Format("2006-01-02 15:04:05.000")
+	levelStr := strings.ToUpper(levelToString(e.Level))
+
+	logLine := fmt.Sprintf("%s [%s] [%s] %s - %s",
+		timestamp,
+		levelStr,
+		e.Service,
+		e.Caller,
+		e.Message,
+	)
+
+	if len(e.Fields) > 0 {
+		fieldsStr := ""
+		for k, v := range e.Fields {
+			fieldsStr += fmt.Sprintf("%s=%v ", k, v)
+		}
+		logLine += fmt.Sprintf(" | %s", strings.TrimSpace(fieldsStr))
+	}
+
+	if e.EventCode != "" {
+		logLine = fmt.Sprintf("[%s] %s", e.EventCode, logLine)
+	}
+
+	return []byte(logLine)
+}
+
+// ColorFormatter renders entries as colorful text for interactive terminals
+// @struct ColorFormatter
+// @package template/infrastructure/logger
+// @implements Formatter
+// @ast-trackable true
+type ColorFormatter struct{}
+
+// Format renders e as colored text, matching the original PrettyLogger output
+// @method Format
+// @receiver ColorFormatter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (ColorFormatter) Format(e Entry) []byte {
+	timestamp := e.Timestamp.Format("2006-01-02 15:04:05.000")
+	levelStr := strings.ToUpper(levelToString(e.Level))
+	colors := getLevelColors(levelStr)
+
+	coloredTimestamp := colors.Timestamp.Sprint(timestamp)
+	coloredLevel := colors.Level.Sprint(levelStr)
+	coloredService := colors.Service.Sprintf("[%s]", e.Service)
+	coloredCaller := colors.Caller.Sprint(e.Caller)
+	coloredMessage := colors.Message.Sprint(e.Message)
+
+	logLine := fmt.Sprintf("%s [%s] %s %s - %s",
+		coloredTimestamp,
+		coloredLevel,
+		coloredService,
+		coloredCaller,
+		coloredMessage,
+	)
+
+	if len(e.Fields) > 0 {
+		fieldsStr := ""
+		for k, v := range e.Fields {
+			fieldsStr += fmt.Sprintf("%s=%v ", k, v)
+		}
+		logLine += color.New(color.FgHiBlack).Sprintf(" | %s", strings.TrimSpace(fieldsStr))
+	}
+
+	if e.EventCode != "" {
+		logLine = color.New(color.FgCyan).Sprintf("[%s] ", e.EventCode) + logLine
+	}
+
+	return []byte(logLine)
+}
+
+// jsonReservedKeys are the top-level keys JSONFormatter always writes itself;
+// a field sharing one of these keys is nested under "fields" instead
+// @const jsonReservedKeys
+// @package template/infrastructure/logger
+// @ast-trackable true
+var jsonReservedKeys = map[string]bool{
+	"time": true, "level": true, "service": true,
+	"event": true, "caller": true, "msg": true, "fields": true,
+}
+
+// JSONFormatter renders one JSON object per line, suitable for shipping to
+// Loki/ELK/Datadog
+// @struct JSONFormatter
+// @package template/infrastructure/logger
+// @implements Formatter
+// @ast-trackable true
+type JSONFormatter struct{}
+
+// Format renders e as a single JSON object with stable key ordering: time,
+// level, service, event, caller, msg, then fields flattened at the top
+// level (or nested under "fields" if a field key collides with one above)
+// @method Format
+// @receiver JSONFormatter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (JSONFormatter) Format(e Entry) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	first := true
+	writeKV := func(key string, value interface{}) {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		kb, _ := json.Marshal(key)
+		buf.Write(kb)
+		buf.WriteByte(':')
+		if errVal, ok := value.(error); ok {
+			value = errVal.Error()
+		}
+		vb, err := json.Marshal(value)
+		if err != nil {
+			vb, _ = json.Marshal(fmt.Sprintf("%v", value))
+		}
+		buf.Write(vb)
+	}
+
+	writeKV("time", e.Timestamp.UTC().Format(time.RFC3339Nano))
+	writeKV("level", strings.ToUpper(levelToString(e.Level)))
+	writeKV("service", e.Service)
+	if e.EventCode != "" {
+		writeKV("event", e.EventCode)
+	}
+	writeKV("caller", e.Caller)
+	writeKV("msg", e.Message)
+
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	collided := make(map[string]interface{})
+	for _, k := range keys {
+		if jsonReservedKeys[k] {
+			collided[k] = e.Fields[k]
+			continue
+		}
+		writeKV(k, e.Fields[k])
+	}
+	if len(collided) > 0 {
+		writeKV("fields", collided)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
+// levelColors holds color configuration for different log levels
+// @struct levelColors
+// @package template/infrastructure/logger
+// @fields Timestamp,Level,Service,Caller,Message
+// @private true
+// @ast-trackable true
+type levelColors struct {
+	Timestamp *color.Color
+	Level     *color.Color
+	Service   *color.Color
+	Caller    *color.Color
+	Message   *color.Color
+}
+
+// getLevelColors returns color configuration for a log level
+// @function getLevelColors
+// @package template/infrastructure/logger
+// @params levelStr string - Log level string
+// @returns *levelColors - Color configuration
+// @pure true
+// @ast-trackable true
+func getLevelColors(levelStr string) *levelColors {
+	switch strings.ToLower(levelStr) {
+	case "debug":
+		return &levelColors{
+			Timestamp: color.New(color.FgHiCyan),
+			Level:     color.New(color.FgCyan),
+			Service:   color.New(color.FgBlue),
+			Caller:    color.New(color.FgHiCyan),
+			Message:   color.New(color.FgHiWhite),
+		}
+	case "info":
+		return &levelColors{
+			Timestamp: color.New(color.FgHiGreen),
+			Level:     color.New(color.FgGreen),
+			Service:   color.New(color.FgHiMagenta),
+			Caller:    color.New(color.FgHiGreen),
+			Message:   color.New(color.FgWhite),
+		}
+	case "success":
+		return &levelColors{
+			Timestamp: color.New(color.FgHiGreen),
+			Level:     color.New(color.FgHiWhite),
+			Service:   color.New(color.FgGreen),
+			Caller:    color.New(color.FgHiWhite),
+			Message:   color.New(color.FgHiGreen),
+		}
+	case "warn":
+		return &levelColors{
+			Timestamp: color.New(color.FgHiYellow),
+			Level:     color.New(color.FgYellow),
+			Service:   color.New(color.FgHiYellow),
+			Caller:    color.New(color.FgHiYellow),
+			Message:   color.New(color.FgYellow),
+		}
+	case "error":
+		return &levelColors{
+			Timestamp: color.New(color.FgHiRed),
+			Level:     color.New(color.FgRed),
+			Service:   color.New(color.FgHiRed),
+			Caller:    color.New(color.FgHiRed),
+			Message:   color.New(color.FgRed),
+		}
+	case "critical":
+		return &levelColors{
+			Timestamp: color.New(color.BgRed, color.FgHiWhite),
+			Level:     color.New(color.BgRed, color.FgHiWhite),
+			Service:   color.New(color.BgHiRed, color.FgHiWhite),
+			Caller:    color.New(color.BgRed, color.FgHiWhite),
+			Message:   color.New(color.BgHiRed, color.FgHiWhite),
+		}
+	default:
+		return &levelColors{
+			Timestamp: color.New(color.FgWhite),
+			Level:     color.New(color.FgWhite),
+			Service:   color.New(color.FgWhite),
+			Caller:    color.New(color.FgWhite),
+			Message:   color.New(color.FgWhite),
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// FILE: template/infrastructure/logger/formatter_test.go
+
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEntry(fields map[string]interface{}) Entry {
+	return Entry{
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:     LevelInfo,
+		Service:   "APP",
+		EventCode: "TEST_EVENT",
+		Caller:    "main.go:10",
+		Fields:    fields,
+		Message:   "hello",
+	}
+}
+
+func TestJSONFormatterRendersErrorValueViaError(t *testing.T) {
+	out := string(JSONFormatter{}.Format(testEntry(map[string]interface{}{
+		"error": errors.New("bind: address already in use"),
+	})))
+
+	if !strings.Contains(out, `"error":"bind: address already in use"`) {
+		t.Fatalf("got %s, want an \"error\" field rendered via Error()", out)
+	}
+	if strings.Contains(out, `"error":{}`) {
+		t.Fatalf("got %s, error value collapsed to {}", out)
+	}
+}
+
+func TestJSONFormatterNestsReservedKeyCollisions(t *testing.T) {
+	out := string(JSONFormatter{}.Format(testEntry(map[string]interface{}{
+		"level": "overridden",
+		"user":  "alice",
+	})))
+
+	if !strings.Contains(out, `"level":"INFO"`) {
+		t.Fatalf("got %s, want top-level \"level\" left as the entry's own level", out)
+	}
+	if !strings.Contains(out, `"fields":{"level":"overridden"}`) {
+		t.Fatalf("got %s, want colliding field nested under \"fields\"", out)
+	}
+	if !strings.Contains(out, `"user":"alice"`) {
+		t.Fatalf("got %s, want non-colliding field written at the top level", out)
+	}
+}
+
+func TestJSONFormatterOmitsEventWhenEmpty(t *testing.T) {
+	e := testEntry(nil)
+	e.EventCode = ""
+
+	out := string(JSONFormatter{}.Format(e))
+	if strings.Contains(out, `"event"`) {
+		t.Fatalf("got %s, want no \"event\" key for an empty EventCode", out)
+	}
+}
+
+func TestLogfmtFormatterQuotesValuesNeedingEscaping(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "plain", value: "bar", want: "foo=bar"},
+		{name: "space", value: "has space", want: `foo="has space"`},
+		{name: "equals", value: "a=b", want: `foo="a=b"`},
+		{name: "quote", value: `say "hi"`, want: `foo="say \"hi\""`},
+		{name: "empty", value: "", want: `foo=""`},
+		{name: "error", value: errors.New("bind: address already in use"), want: `foo="bind: address already in use"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := string(LogfmtFormatter{}.Format(testEntry(map[string]interface{}{"foo": tt.value})))
+			if !strings.Contains(out, tt.want) {
+				t.Fatalf("got %s, want it to contain %s", out, tt.want)
+			}
+		})
+	}
+}
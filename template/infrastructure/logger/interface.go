@@ -0,0 +1,40 @@
+// FILE: template/infrastructure/logger/interface.go
+
+package logger
+
+import "context"
+
+// Logger is the logging contract consumed by application code. Depending on
+// this interface instead of the concrete *PrettyLogger lets callers swap in
+// alternate implementations such as Nop (for tests) or Tee (to fan out to
+// multiple backends)
+// @interface Logger
+// @package template/infrastructure/logger
+// @method Debug,Info,Success,Warning,Error,Critical,Fatal,SetLevel,DebugCtx,InfoCtx,SuccessCtx,WarningCtx,ErrorCtx,CriticalCtx,FatalCtx
+// @ast-trackable true
+type Logger interface {
+	Debug(eventCode string, fields map[string]interface{}, message string)
+	Info(eventCode string, fields map[string]interface{}, message string)
+	Success(eventCode string, fields map[string]interface{}, message string)
+	Warning(eventCode string, fields map[string]interface{}, message string)
+	Error(eventCode string, fields map[string]interface{}, message string)
+	Critical(eventCode string, fields map[string]interface{}, message string)
+	// Fatal logs at LevelCritical and then terminates the process via os.Exit(1)
+	Fatal(eventCode string, fields map[string]interface{}, message string)
+	SetLevel(level LogLevel)
+
+	// The Ctx variants behave like their non-Ctx counterparts, but first
+	// extract any registered context keys (see RegisterContextKey) from ctx
+	// and merge them into the logged fields, so request-scoped values such
+	// as request ID and user flow into every log line without being passed
+	// explicitly by the caller
+	DebugCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+	InfoCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+	SuccessCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+	WarningCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+	ErrorCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+	CriticalCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+	FatalCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string)
+}
+
+var _ Logger = (*PrettyLogger)(nil)
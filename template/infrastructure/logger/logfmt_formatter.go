@@ -0,0 +1,82 @@
+// FILE: template/infrastructure/logger/logfmt_formatter.go
+
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogfmtFormatter renders entries as go-kit-style logfmt (space-separated
+// key=value pairs), convenient for machine ingestion without full JSON
+// @struct LogfmtFormatter
+// @package template/infrastructure/logger
+// @implements Formatter
+// @ast-trackable true
+type LogfmtFormatter struct{}
+
+// Format renders e as one logfmt line: time, level, service, event (if
+// present), caller, msg, then each field sorted by key
+// @method Format
+// @receiver LogfmtFormatter
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (LogfmtFormatter) Format(e Entry) []byte {
+	var b strings.Builder
+
+	writeLogfmtKV(&b, "time", e.Timestamp.UTC().Format(time.RFC3339Nano))
+	b.WriteByte(' ')
+	writeLogfmtKV(&b, "level", strings.ToUpper(levelToString(e.Level)))
+	b.WriteByte(' ')
+	writeLogfmtKV(&b, "service", e.Service)
+	if e.EventCode != "" {
+		b.WriteByte(' ')
+		writeLogfmtKV(&b, "event", e.EventCode)
+	}
+	b.WriteByte(' ')
+	writeLogfmtKV(&b, "caller", e.Caller)
+	b.WriteByte(' ')
+	writeLogfmtKV(&b, "msg", e.Message)
+
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		b.WriteByte(' ')
+		writeLogfmtKV(&b, k, e.Fields[k])
+	}
+
+	return []byte(b.String())
+}
+
+// writeLogfmtKV appends "key=value" to b, quoting value if it contains a
+// space, an equals sign, a quote, or is empty
+// @function writeLogfmtKV
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func writeLogfmtKV(b *strings.Builder, key string, value interface{}) {
+	b.WriteString(key)
+	b.WriteByte('=')
+	b.WriteString(logfmtValue(value))
+}
+
+// logfmtValue renders value as a logfmt-safe string, quoting when needed
+// @function logfmtValue
+// @package template/infrastructure/logger
+// @private true
+// @pure true
+// @ast-trackable true
+func logfmtValue(value interface{}) string {
+	s := fmt.Sprintf("%v", value)
+	if s == "" || strings.ContainsAny(s, " =\"") {
+		return strconv.Quote(s)
+	}
+	return s
+}
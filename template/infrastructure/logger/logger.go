@@ -10,8 +10,6 @@ import (
 	"strings"
 	"sync"
 	"time"
-
-	"github.com/fatih/color"
 )
 
 // LogLevel represents the severity level of log messages
@@ -45,15 +43,19 @@ const (
 // PrettyLogger provides structured, colorful logging functionality
 // @struct PrettyLogger
 // @package template/infrastructure/logger
-// @fields level,output,service,colorful,mu
+// @fields level,output,service,formatter,mu,parent,presetFields,ctxKeys
 // @thread-safe true
 // @ast-trackable true
 type PrettyLogger struct {
-	level    LogLevel
-	output   io.Writer
-	service  string
-	colorful bool
-	mu       sync.Mutex
+	level     LogLevel
+	output    io.Writer
+	service   string
+	formatter Formatter
+	mu        sync.Mutex
+
+	parent       *PrettyLogger
+	presetFields map[string]interface{}
+	ctxKeys      []any
 }
 
 // NewPrettyLogger creates and initializes a new PrettyLogger instance
@@ -68,14 +70,35 @@ type PrettyLogger struct {
 // @ast-trackable true
 // @factory-function true
 func NewPrettyLogger(service string, level LogLevel, colorful bool) *PrettyLogger {
+	var formatter Formatter = TextFormatter{}
+	if colorful {
+		formatter = ColorFormatter{}
+	}
+	return NewPrettyLoggerWithFormatter(service, level, formatter, os.Stdout)
+}
+
+// NewPrettyLoggerWithFormatter creates a PrettyLogger that renders entries
+// through a custom Formatter and writes the result to out
+// @constructor PrettyLogger
+// @package template/infrastructure/logger
+// @function NewPrettyLoggerWithFormatter
+// @params service string - Service name identifier (defaults to "APP" if empty)
+// @params level LogLevel - Minimum log level to output
+// @params f Formatter - Formatter used to render each log entry
+// @params out io.Writer - Destination for rendered log lines
+// @returns *PrettyLogger - Configured logger instance
+// @usage appLogger := logger.NewPrettyLoggerWithFormatter("APP", logger.LevelInfo, logger.JSONFormatter{}, os.Stdout)
+// @ast-trackable true
+// @factory-function true
+func NewPrettyLoggerWithFormatter(service string, level LogLevel, f Formatter, out io.Writer) *PrettyLogger {
 	if service == "" {
 		service = "APP"
 	}
 	return &PrettyLogger{
-		level:    level,
-		output:   os.Stdout,
-		service:  service,
-		colorful: colorful,
+		level:     level,
+		output:    out,
+		service:   service,
+		formatter: f,
 	}
 }
 
@@ -87,9 +110,39 @@ func NewPrettyLogger(service string, level LogLevel, colorful bool) *PrettyLogge
 // @thread-safe true
 // @ast-trackable true
 func (l *PrettyLogger) SetLevel(level LogLevel) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	l.level = level
+	root := l.root()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	root.level = level
+}
+
+// SetFormatter swaps the Formatter used to render subsequent log entries
+// @method SetFormatter
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @params f Formatter - New formatter
+// @thread-safe true
+// @ast-trackable true
+func (l *PrettyLogger) SetFormatter(f Formatter) {
+	root := l.root()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	root.formatter = f
+}
+
+// root walks up the parent chain and returns the logger holding the shared
+// level/output/formatter configuration
+// @method root
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func (l *PrettyLogger) root() *PrettyLogger {
+	cur := l
+	for cur.parent != nil {
+		cur = cur.parent
+	}
+	return cur
 }
 
 // Debug logs a debug level message
@@ -170,6 +223,20 @@ func (l *PrettyLogger) Critical(eventCode string, fields map[string]interface{},
 	l.log(LevelCritical, eventCode, fields, message)
 }
 
+// Fatal logs a critical level message and then terminates the process
+// @method Fatal
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @params eventCode string - Event identifier
+// @params fields map[string]interface{} - Additional data fields
+// @params message string - Log message
+// @log-level critical
+// @ast-trackable true
+func (l *PrettyLogger) Fatal(eventCode string, fields map[string]interface{}, message string) {
+	l.log(LevelCritical, eventCode, fields, message)
+	os.Exit(1)
+}
+
 // log is the internal logging method that handles all log levels
 // @method log
 // @receiver *PrettyLogger
@@ -181,98 +248,54 @@ func (l *PrettyLogger) Critical(eventCode string, fields map[string]interface{},
 // @private true
 // @ast-trackable true
 func (l *PrettyLogger) log(level LogLevel, eventCode string, fields map[string]interface{}, message string) {
-	if level < l.level {
+	root := l.root()
+	if level < root.level {
 		return
 	}
 
-	timestamp := time.Now().UTC().Format("2006-01-02 15:04:05.000")
-	levelStr := strings.ToUpper(levelToString(level))
-
-	_, file, line, _ := runtime.Caller(3)
-	caller := fmt.Sprintf("%s:%d", shortenFilePath(file), line)
-
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	if l.colorful {
-		l.printColorful(timestamp, levelStr, eventCode, caller, fields, message)
-	} else {
-		l.printPlain(timestamp, levelStr, eventCode, caller, fields, message)
+	merged := l.mergedFields()
+	for k, v := range fields {
+		merged[k] = v
 	}
-}
-
-// printColorful prints colored log output
-// @method printColorful
-// @receiver *PrettyLogger
-// @package template/infrastructure/logger
-// @params timestamp,levelStr,eventCode,caller string
-// @params fields map[string]interface{}
-// @params message string
-// @private true
-// @ast-trackable true
-func (l *PrettyLogger) printColorful(timestamp, levelStr, eventCode, caller string, fields map[string]interface{}, message string) {
-	colors := getLevelColors(levelStr)
-
-	coloredTimestamp := colors.Timestamp.Sprint(timestamp)
-	coloredLevel := colors.Level.Sprint(levelStr)
-	coloredService := colors.Service.Sprintf("[%s]", l.service)
-	coloredCaller := colors.Caller.Sprint(caller)
-	coloredMessage := colors.Message.Sprint(message)
 
-	logLine := fmt.Sprintf("%s [%s] %s %s - %s",
-		coloredTimestamp,
-		coloredLevel,
-		coloredService,
-		coloredCaller,
-		coloredMessage,
-	)
-
-	if len(fields) > 0 {
-		fieldsStr := ""
-		for k, v := range fields {
-			fieldsStr += fmt.Sprintf("%s=%v ", k, v)
-		}
-		logLine += color.New(color.FgHiBlack).Sprintf(" | %s", strings.TrimSpace(fieldsStr))
+	_, file, line, _ := runtime.Caller(3)
+	entry := Entry{
+		Timestamp: time.Now().UTC(),
+		Level:     level,
+		Service:   root.service,
+		EventCode: eventCode,
+		Caller:    fmt.Sprintf("%s:%d", shortenFilePath(file), line),
+		Fields:    merged,
+		Message:   message,
 	}
 
-	if eventCode != "" {
-		logLine = color.New(color.FgCyan).Sprintf("[%s] ", eventCode) + logLine
-	}
+	root.mu.Lock()
+	defer root.mu.Unlock()
 
-	fmt.Fprintln(l.output, logLine)
+	rendered := root.formatter.Format(entry)
+	root.output.Write(append(rendered, '\n'))
 }
 
-// printPlain prints plain text log output
-// @method printPlain
+// mergedFields walks the parent chain from the root down to l, merging each
+// ancestor's presetFields so that a closer logger's fields take precedence
+// @method mergedFields
 // @receiver *PrettyLogger
 // @package template/infrastructure/logger
-// @params timestamp,levelStr,eventCode,caller string
-// @params fields map[string]interface{}
-// @params message string
 // @private true
 // @ast-trackable true
-func (l *PrettyLogger) printPlain(timestamp, levelStr, eventCode, caller string, fields map[string]interface{}, message string) {
-	logLine := fmt.Sprintf("%s [%s] [%s] %s - %s",
-		timestamp,
-		levelStr,
-		l.service,
-		caller,
-		message,
-	)
-
-	if len(fields) > 0 {
-		fieldsStr := ""
-		for k, v := range fields {
-			fieldsStr += fmt.Sprintf("%s=%v ", k, v)
-		}
-		logLine += fmt.Sprintf(" | %s", strings.TrimSpace(fieldsStr))
+func (l *PrettyLogger) mergedFields() map[string]interface{} {
+	var chain []*PrettyLogger
+	for cur := l; cur != nil; cur = cur.parent {
+		chain = append(chain, cur)
 	}
 
-	if eventCode != "" {
-		logLine = fmt.Sprintf("[%s] %s", eventCode, logLine)
+	merged := make(map[string]interface{})
+	for i := len(chain) - 1; i >= 0; i-- {
+		for k, v := range chain[i].presetFields {
+			merged[k] = v
+		}
 	}
-
-	fmt.Fprintln(l.output, logLine)
+	return merged
 }
 
 // levelToString converts LogLevel to string representation
@@ -315,85 +338,3 @@ func shortenFilePath(path string) string {
 	}
 	return path
 }
-
-// levelColors holds color configuration for different log levels
-// @struct levelColors
-// @package template/infrastructure/logger
-// @fields Timestamp,Level,Service,Caller,Message
-// @private true
-// @ast-trackable true
-type levelColors struct {
-	Timestamp *color.Color
-	Level     *color.Color
-	Service   *color.Color
-	Caller    *color.Color
-	Message   *color.Color
-}
-
-// getLevelColors returns color configuration for a log level
-// @function getLevelColors
-// @package template/infrastructure/logger
-// @params levelStr string - Log level string
-// @returns *levelColors - Color configuration
-// @pure true
-// @ast-trackable true
-func getLevelColors(levelStr string) *levelColors {
-	switch strings.ToLower(levelStr) {
-	case "debug":
-		return &levelColors{
-			Timestamp: color.New(color.FgHiCyan),
-			Level:     color.New(color.FgCyan),
-			Service:   color.New(color.FgBlue),
-			Caller:    color.New(color.FgHiCyan),
-			Message:   color.New(color.FgHiWhite),
-		}
-	case "info":
-		return &levelColors{
-			Timestamp: color.New(color.FgHiGreen),
-			Level:     color.New(color.FgGreen),
-			Service:   color.New(color.FgHiMagenta),
-			Caller:    color.New(color.FgHiGreen),
-			Message:   color.New(color.FgWhite),
-		}
-	case "success":
-		return &levelColors{
-			Timestamp: color.New(color.FgHiGreen),
-			Level:     color.New(color.FgHiWhite),
-			Service:   color.New(color.FgGreen),
-			Caller:    color.New(color.FgHiWhite),
-			Message:   color.New(color.FgHiGreen),
-		}
-	case "warn":
-		return &levelColors{
-			Timestamp: color.New(color.FgHiYellow),
-			Level:     color.New(color.FgYellow),
-			Service:   color.New(color.FgHiYellow),
-			Caller:    color.New(color.FgHiYellow),
-			Message:   color.New(color.FgYellow),
-		}
-	case "error":
-		return &levelColors{
-			Timestamp: color.New(color.FgHiRed),
-			Level:     color.New(color.FgRed),
-			Service:   color.New(color.FgHiRed),
-			Caller:    color.New(color.FgHiRed),
-			Message:   color.New(color.FgRed),
-		}
-	case "critical":
-		return &levelColors{
-			Timestamp: color.New(color.BgRed, color.FgHiWhite),
-			Level:     color.New(color.BgRed, color.FgHiWhite),
-			Service:   color.New(color.BgHiRed, color.FgHiWhite),
-			Caller:    color.New(color.BgRed, color.FgHiWhite),
-			Message:   color.New(color.BgHiRed, color.FgHiWhite),
-		}
-	default:
-		return &levelColors{
-			Timestamp: color.New(color.FgWhite),
-			Level:     color.New(color.FgWhite),
-			Service:   color.New(color.FgWhite),
-			Caller:    color.New(color.FgWhite),
-			Message:   color.New(color.FgWhite),
-		}
-	}
-}
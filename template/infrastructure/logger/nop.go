@@ -0,0 +1,123 @@
+// FILE: template/infrastructure/logger/nop.go
+
+package logger
+
+import "context"
+
+// Nop is a Logger that discards every log call. It's the natural stand-in
+// for *logger.PrettyLogger in unit tests that don't care about log output
+// @struct Nop
+// @package template/infrastructure/logger
+// @implements Logger
+// @usage svc := &handlers.TestService{Logger: logger.Nop{}}
+// @ast-trackable true
+type Nop struct{}
+
+var _ Logger = Nop{}
+
+// Debug discards the call
+// @method Debug
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Debug(string, map[string]interface{}, string) {}
+
+// Info discards the call
+// @method Info
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Info(string, map[string]interface{}, string) {}
+
+// Success discards the call
+// @method Success
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Success(string, map[string]interface{}, string) {}
+
+// Warning discards the call
+// @method Warning
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Warning(string, map[string]interface{}, string) {}
+
+// Error discards the call
+// @method Error
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Error(string, map[string]interface{}, string) {}
+
+// Critical discards the call
+// @method Critical
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Critical(string, map[string]interface{}, string) {}
+
+// Fatal discards the call without terminating the process, so tests using
+// Nop don't exit unexpectedly
+// @method Fatal
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) Fatal(string, map[string]interface{}, string) {}
+
+// SetLevel is a no-op
+// @method SetLevel
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) SetLevel(LogLevel) {}
+
+// DebugCtx discards the call
+// @method DebugCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) DebugCtx(context.Context, string, map[string]interface{}, string) {}
+
+// InfoCtx discards the call
+// @method InfoCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) InfoCtx(context.Context, string, map[string]interface{}, string) {}
+
+// SuccessCtx discards the call
+// @method SuccessCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) SuccessCtx(context.Context, string, map[string]interface{}, string) {}
+
+// WarningCtx discards the call
+// @method WarningCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) WarningCtx(context.Context, string, map[string]interface{}, string) {}
+
+// ErrorCtx discards the call
+// @method ErrorCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) ErrorCtx(context.Context, string, map[string]interface{}, string) {}
+
+// CriticalCtx discards the call
+// @method CriticalCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) CriticalCtx(context.Context, string, map[string]interface{}, string) {}
+
+// FatalCtx discards the call without terminating the process, so tests using
+// Nop don't exit unexpectedly
+// @method FatalCtx
+// @receiver Nop
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (Nop) FatalCtx(context.Context, string, map[string]interface{}, string) {}
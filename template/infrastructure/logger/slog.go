@@ -0,0 +1,150 @@
+// FILE: template/infrastructure/logger/slog.go
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LevelCriticalSlog is the slog.Level mapped to LevelCritical, one step above
+// the built-in slog.LevelError (which is 8)
+// @const LevelCriticalSlog
+// @package template/infrastructure/logger
+// @type slog.Level
+// @ast-trackable true
+const LevelCriticalSlog slog.Level = 12
+
+// slogHandler adapts a *PrettyLogger to the standard library log/slog.Handler
+// interface
+// @struct slogHandler
+// @package template/infrastructure/logger
+// @fields logger,groupPrefix,attrs
+// @private true
+// @ast-trackable true
+type slogHandler struct {
+	logger      *PrettyLogger
+	groupPrefix string
+	attrs       map[string]interface{}
+}
+
+// NewSlogHandler wraps a *PrettyLogger so it can back a standard *slog.Logger
+// @constructor slogHandler
+// @package template/infrastructure/logger
+// @function NewSlogHandler
+// @params l *PrettyLogger - Logger instance to adapt
+// @returns slog.Handler - Handler backed by l
+// @usage slog.New(logger.NewSlogHandler(appLogger))
+// @ast-trackable true
+// @factory-function true
+func NewSlogHandler(l *PrettyLogger) slog.Handler {
+	return &slogHandler{logger: l}
+}
+
+// Slog returns a *slog.Logger backed by this PrettyLogger, so downstream
+// packages can depend on the standard library logging API
+// @method Slog
+// @receiver *PrettyLogger
+// @package template/infrastructure/logger
+// @returns *slog.Logger - slog logger backed by the receiver
+// @ast-trackable true
+func (l *PrettyLogger) Slog() *slog.Logger {
+	return slog.New(NewSlogHandler(l))
+}
+
+// slogLevelToLogLevel maps a slog.Level onto the existing LogLevel constants
+// @function slogLevelToLogLevel
+// @package template/infrastructure/logger
+// @params level slog.Level - slog level to convert
+// @returns LogLevel - Equivalent LogLevel
+// @pure true
+// @ast-trackable true
+func slogLevelToLogLevel(level slog.Level) LogLevel {
+	switch {
+	case level >= LevelCriticalSlog:
+		return LevelCritical
+	case level >= slog.LevelError:
+		return LevelError
+	case level >= slog.LevelWarn:
+		return LevelWarning
+	case level >= slog.LevelInfo:
+		return LevelInfo
+	default:
+		return LevelDebug
+	}
+}
+
+// Enabled reports whether the handler should emit records at the given level
+// @method Enabled
+// @receiver *slogHandler
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return slogLevelToLogLevel(level) >= h.logger.root().level
+}
+
+// Handle converts an slog.Record into a PrettyLogger log call
+// @method Handle
+// @receiver *slogHandler
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	fields := make(map[string]interface{}, len(h.attrs)+r.NumAttrs())
+	for k, v := range h.attrs {
+		fields[k] = v
+	}
+
+	r.Attrs(func(a slog.Attr) bool {
+		fields[h.prefixedKey(a.Key)] = a.Value.Any()
+		return true
+	})
+
+	h.logger.log(slogLevelToLogLevel(r.Level), "", fields, r.Message)
+	return nil
+}
+
+// WithAttrs returns a new handler with the given attributes merged into its
+// preset fields, applying the current group prefix to each key
+// @method WithAttrs
+// @receiver *slogHandler
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	merged := make(map[string]interface{}, len(h.attrs)+len(attrs))
+	for k, v := range h.attrs {
+		merged[k] = v
+	}
+	for _, a := range attrs {
+		merged[h.prefixedKey(a.Key)] = a.Value.Any()
+	}
+
+	return &slogHandler{logger: h.logger, groupPrefix: h.groupPrefix, attrs: merged}
+}
+
+// WithGroup returns a new handler whose subsequent attribute keys are
+// prefixed with name (dot-separated, nesting with any existing prefix)
+// @method WithGroup
+// @receiver *slogHandler
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	prefix := name
+	if h.groupPrefix != "" {
+		prefix = h.groupPrefix + "." + name
+	}
+
+	return &slogHandler{logger: h.logger, groupPrefix: prefix, attrs: h.attrs}
+}
+
+// prefixedKey applies the handler's current group prefix to an attribute key
+// @method prefixedKey
+// @receiver *slogHandler
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func (h *slogHandler) prefixedKey(key string) string {
+	if h.groupPrefix == "" {
+		return key
+	}
+	return h.groupPrefix + "." + key
+}
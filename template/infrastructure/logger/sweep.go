@@ -0,0 +1,167 @@
+// FILE: template/infrastructure/logger/sweep.go
+
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Uploader ships a closed log file somewhere durable (e.g. object storage)
+// @interface Uploader
+// @package template/infrastructure/logger
+// @method Upload(path string) error
+// @ast-trackable true
+type Uploader interface {
+	Upload(path string) error
+}
+
+// DirectorySweeper periodically walks a rotated-log directory, uploads every
+// closed (non-current) file through an Uploader via a worker pool, and
+// deletes each file once its upload succeeds
+// @struct DirectorySweeper
+// @package template/infrastructure/logger
+// @fields dir,interval,uploader,workers,currentFn,stop,wg
+// @ast-trackable true
+type DirectorySweeper struct {
+	dir       string
+	interval  time.Duration
+	uploader  Uploader
+	workers   int
+	currentFn func() string
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// defaultSweepInterval is used in place of a non-positive interval passed to
+// NewDirectorySweeper, since time.NewTicker panics on one
+// @const defaultSweepInterval
+// @package template/infrastructure/logger
+// @ast-trackable true
+const defaultSweepInterval = time.Minute
+
+// NewDirectorySweeper creates a DirectorySweeper over dir. currentFn, if
+// non-nil, should return the path of the file still being actively written
+// (e.g. RotatingFileWriter's current file) so the sweep skips it
+// @constructor DirectorySweeper
+// @package template/infrastructure/logger
+// @function NewDirectorySweeper
+// @params dir string - Directory to sweep
+// @params interval time.Duration - How often to sweep (non-positive values fall back to defaultSweepInterval)
+// @params uploader Uploader - Destination for closed log files
+// @params workers int - Upload worker pool size (minimum 1)
+// @params currentFn func() string - Returns the path to exclude from sweeping, or nil
+// @returns *DirectorySweeper - Configured, not-yet-started sweeper
+// @usage sweeper := logger.NewDirectorySweeper(dir, time.Minute, uploader, 4, nil)
+// @ast-trackable true
+// @factory-function true
+func NewDirectorySweeper(dir string, interval time.Duration, uploader Uploader, workers int, currentFn func() string) *DirectorySweeper {
+	if workers < 1 {
+		workers = 1
+	}
+	if interval <= 0 {
+		interval = defaultSweepInterval
+	}
+
+	return &DirectorySweeper{
+		dir:       dir,
+		interval:  interval,
+		uploader:  uploader,
+		workers:   workers,
+		currentFn: currentFn,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start begins sweeping dir on the configured interval in a background goroutine
+// @method Start
+// @receiver *DirectorySweeper
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (s *DirectorySweeper) Start() {
+	s.wg.Add(1)
+	go s.run()
+}
+
+// Stop signals the sweeper to halt and waits for the in-flight sweep (if any)
+// to finish
+// @method Stop
+// @receiver *DirectorySweeper
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (s *DirectorySweeper) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+// run is the sweeper's background ticker loop
+// @method run
+// @receiver *DirectorySweeper
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func (s *DirectorySweeper) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep()
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// sweep uploads and removes every closed file in dir using a worker pool
+// @method sweep
+// @receiver *DirectorySweeper
+// @package template/infrastructure/logger
+// @private true
+// @ast-trackable true
+func (s *DirectorySweeper) sweep() {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+
+	var current string
+	if s.currentFn != nil {
+		current = s.currentFn()
+	}
+
+	paths := make(chan string)
+	var workers sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for path := range paths {
+				if err := s.uploader.Upload(path); err == nil {
+					os.Remove(path)
+				}
+			}
+		}()
+	}
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(s.dir, e.Name())
+		if path == current {
+			continue
+		}
+		paths <- path
+	}
+	close(paths)
+
+	workers.Wait()
+}
@@ -0,0 +1,204 @@
+// FILE: template/infrastructure/logger/tee.go
+
+package logger
+
+import (
+	"context"
+	"os"
+)
+
+// teeLogger fans every log call out to a fixed set of Loggers
+// @struct teeLogger
+// @package template/infrastructure/logger
+// @implements Logger
+// @fields loggers
+// @private true
+// @ast-trackable true
+type teeLogger struct {
+	loggers []Logger
+}
+
+// Tee returns a Logger that fans every call out to each of loggers, in order
+// @function Tee
+// @package template/infrastructure/logger
+// @params loggers ...Logger - Loggers to fan out to
+// @returns Logger - Combined logger
+// @usage log := logger.Tee(appLogger, logger.NewPrettyLoggerWithFormatter("APP", logger.LevelInfo, logger.JSONFormatter{}, fileWriter))
+// @ast-trackable true
+// @factory-function true
+func Tee(loggers ...Logger) Logger {
+	return &teeLogger{loggers: loggers}
+}
+
+var _ Logger = (*teeLogger)(nil)
+
+// Debug fans out to every wrapped logger
+// @method Debug
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Debug(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Debug(eventCode, fields, message)
+	}
+}
+
+// Info fans out to every wrapped logger
+// @method Info
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Info(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Info(eventCode, fields, message)
+	}
+}
+
+// Success fans out to every wrapped logger
+// @method Success
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Success(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Success(eventCode, fields, message)
+	}
+}
+
+// Warning fans out to every wrapped logger
+// @method Warning
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Warning(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Warning(eventCode, fields, message)
+	}
+}
+
+// Error fans out to every wrapped logger
+// @method Error
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Error(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Error(eventCode, fields, message)
+	}
+}
+
+// Critical fans out to every wrapped logger
+// @method Critical
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Critical(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Critical(eventCode, fields, message)
+	}
+}
+
+// SetLevel propagates the new level to every wrapped logger
+// @method SetLevel
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) SetLevel(level LogLevel) {
+	for _, l := range t.loggers {
+		l.SetLevel(level)
+	}
+}
+
+// Fatal logs a critical message to every wrapped logger (via Critical, so a
+// single logger exiting early can't stop the others from receiving it) and
+// then terminates the process once
+// @method Fatal
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) Fatal(eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.Critical(eventCode, fields, message)
+	}
+	os.Exit(1)
+}
+
+// DebugCtx fans out to every wrapped logger
+// @method DebugCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) DebugCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.DebugCtx(ctx, eventCode, fields, message)
+	}
+}
+
+// InfoCtx fans out to every wrapped logger
+// @method InfoCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) InfoCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.InfoCtx(ctx, eventCode, fields, message)
+	}
+}
+
+// SuccessCtx fans out to every wrapped logger
+// @method SuccessCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) SuccessCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.SuccessCtx(ctx, eventCode, fields, message)
+	}
+}
+
+// WarningCtx fans out to every wrapped logger
+// @method WarningCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) WarningCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.WarningCtx(ctx, eventCode, fields, message)
+	}
+}
+
+// ErrorCtx fans out to every wrapped logger
+// @method ErrorCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) ErrorCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.ErrorCtx(ctx, eventCode, fields, message)
+	}
+}
+
+// CriticalCtx fans out to every wrapped logger
+// @method CriticalCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) CriticalCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.CriticalCtx(ctx, eventCode, fields, message)
+	}
+}
+
+// FatalCtx logs a critical message to every wrapped logger (via CriticalCtx,
+// so a single logger exiting early can't stop the others from receiving it)
+// and then terminates the process once
+// @method FatalCtx
+// @receiver *teeLogger
+// @package template/infrastructure/logger
+// @ast-trackable true
+func (t *teeLogger) FatalCtx(ctx context.Context, eventCode string, fields map[string]interface{}, message string) {
+	for _, l := range t.loggers {
+		l.CriticalCtx(ctx, eventCode, fields, message)
+	}
+	os.Exit(1)
+}
@@ -0,0 +1,61 @@
+// FILE: template/infrastructure/logger/uploader/s3/s3.go
+
+// Package s3 provides a reference logger.Uploader implementation for
+// shipping rotated log files to an S3-compatible bucket. It lives outside
+// template/infrastructure/logger so the core logger package stays free of
+// cloud SDK dependencies; import it only if you actually want S3 uploads.
+package s3
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"template/infrastructure/logger"
+)
+
+// Uploader is a logger.Uploader that ships files into a single S3 bucket
+// under an optional key prefix
+// @struct Uploader
+// @package template/infrastructure/logger/uploader/s3
+// @implements logger.Uploader
+// @fields Bucket,Prefix
+// @ast-trackable true
+type Uploader struct {
+	Bucket string
+	Prefix string
+}
+
+var _ logger.Uploader = (*Uploader)(nil)
+
+// New creates an Uploader targeting the given bucket and key prefix
+// @constructor Uploader
+// @package template/infrastructure/logger/uploader/s3
+// @function New
+// @params bucket string - Destination S3 bucket
+// @params prefix string - Key prefix uploaded objects are stored under
+// @returns *Uploader - Configured uploader
+// @usage sweeper := logger.NewDirectorySweeper(dir, time.Minute, s3.New("my-bucket", "logs/"), 4, nil)
+// @ast-trackable true
+// @factory-function true
+func New(bucket, prefix string) *Uploader {
+	return &Uploader{Bucket: bucket, Prefix: prefix}
+}
+
+// Upload ships path to u.Bucket under u.Prefix+filepath.Base(path). This is
+// a stub: wire in the S3-compatible client of your choice (e.g.
+// github.com/aws/aws-sdk-go-v2/service/s3) to actually perform the PutObject
+// @method Upload
+// @receiver *Uploader
+// @package template/infrastructure/logger/uploader/s3
+// @ast-trackable true
+func (u *Uploader) Upload(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	key := u.Prefix + filepath.Base(path)
+	return fmt.Errorf("s3 uploader: not implemented — wire in an S3 client to upload %q to bucket %q", key, u.Bucket)
+}
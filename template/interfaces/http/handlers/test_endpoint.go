@@ -0,0 +1,40 @@
+// FILE: template/interfaces/http/handlers/test_endpoint.go
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"template/interfaces/http/transport"
+)
+
+// MakePingEndpoint wraps TestService.Ping as a transport.Endpoint
+// @function MakePingEndpoint
+// @package template/interfaces/http/handlers
+// @params svc *TestService - Service backing the endpoint
+// @returns transport.Endpoint - Endpoint invoking svc.Ping
+// @ast-trackable true
+func MakePingEndpoint(svc *TestService) transport.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return svc.Ping(ctx)
+	}
+}
+
+// DecodePingRequest decodes the /test request; Ping takes no input, so this
+// always succeeds with a nil request value
+// @function DecodePingRequest
+// @package template/interfaces/http/handlers
+// @ast-trackable true
+func DecodePingRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+// EncodePingResponse writes a PingResponse as JSON
+// @function EncodePingResponse
+// @package template/interfaces/http/handlers
+// @ast-trackable true
+func EncodePingResponse(_ context.Context, w http.ResponseWriter, resp interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(resp)
+}
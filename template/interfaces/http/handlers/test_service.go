@@ -0,0 +1,40 @@
+// FILE: template/interfaces/http/handlers/test_service.go
+
+package handlers
+
+import (
+	"context"
+	"template/infrastructure/logger"
+)
+
+// PingResponse is the business response returned by TestService.Ping
+// @struct PingResponse
+// @package template/interfaces/http/handlers
+// @fields Message
+// @json-serializable true
+// @ast-trackable true
+type PingResponse struct {
+	Message string `json:"message"`
+}
+
+// TestService holds the business logic backing the /test endpoint
+// @struct TestService
+// @package template/interfaces/http/handlers
+// @fields Logger
+// @ast-trackable true
+type TestService struct {
+	Logger logger.Logger
+}
+
+// Ping is the service method backing the /test endpoint
+// @method Ping
+// @receiver *TestService
+// @package template/interfaces/http/handlers
+// @params ctx context.Context - Request context
+// @returns PingResponse - Response payload
+// @returns error - Non-nil on failure
+// @ast-trackable true
+func (s *TestService) Ping(ctx context.Context) (PingResponse, error) {
+	s.Logger.InfoCtx(ctx, "TEST_HANDLER", nil, "Test handler called")
+	return PingResponse{Message: "Hello world!"}, nil
+}
@@ -0,0 +1,30 @@
+// FILE: template/interfaces/http/middleware/chain.go
+
+package middleware
+
+import "net/http"
+
+// Middleware wraps an http.Handler to add cross-cutting behavior
+// @type Middleware
+// @package template/interfaces/http/middleware
+// @primitive func(http.Handler) http.Handler
+// @ast-trackable true
+type Middleware func(http.Handler) http.Handler
+
+// Chain composes middlewares into a single Middleware, applying them in the
+// order given so the first middleware listed is outermost (runs first on the
+// way in, last on the way out)
+// @function Chain
+// @package template/interfaces/http/middleware
+// @params mws ...Middleware - Middlewares to compose, outermost first
+// @returns Middleware - Combined middleware
+// @usage middleware.Chain(middleware.RequestID(log), middleware.Recovery(log), middleware.AccessLog(log))
+// @ast-trackable true
+func Chain(mws ...Middleware) Middleware {
+	return func(final http.Handler) http.Handler {
+		for i := len(mws) - 1; i >= 0; i-- {
+			final = mws[i](final)
+		}
+		return final
+	}
+}
@@ -0,0 +1,51 @@
+// FILE: template/interfaces/http/middleware/recovery.go
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"template/infrastructure/logger"
+	"template/interfaces/http/response"
+)
+
+// stackTraceBufSize bounds the buffer used to capture a panic's stack trace
+// @const stackTraceBufSize
+// @package template/interfaces/http/middleware
+// @ast-trackable true
+const stackTraceBufSize = 4096
+
+// Recovery creates a middleware that recovers from panics in downstream
+// handlers, logs them at LevelCritical with a formatted stack trace, and
+// responds with a 500 via response.Error instead of crashing the server
+// @function Recovery
+// @package template/interfaces/http/middleware
+// @params log logger.Logger - Logger instance
+// @returns func(http.Handler) http.Handler - Middleware function
+// @middleware true
+// @http-middleware true
+// @ast-trackable true
+func Recovery(log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					buf := make([]byte, stackTraceBufSize)
+					n := runtime.Stack(buf, false)
+
+					log.CriticalCtx(r.Context(), "HTTP_PANIC", map[string]interface{}{
+						"method":      r.Method,
+						"path":        r.URL.Path,
+						"recover":     fmt.Sprintf("%v", rec),
+						"stack_trace": string(buf[:n]),
+					}, "Recovered from panic")
+
+					response.Error(w, http.StatusInternalServerError, "internal server error")
+				}
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
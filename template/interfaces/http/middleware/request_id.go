@@ -0,0 +1,86 @@
+// FILE: template/interfaces/http/middleware/request_id.go
+
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"template/infrastructure/logger"
+	"template/pkg/ctxutil"
+)
+
+// RequestIDHeader is the HTTP header used to read/propagate the request ID
+// @const RequestIDHeader
+// @package template/interfaces/http/middleware
+// @ast-trackable true
+const RequestIDHeader = "X-Request-ID"
+
+// init registers ctxutil's request ID key so PrettyLogger's WithContext and
+// *Ctx methods auto-attach it as the "request_id" field
+// @function init
+// @package template/interfaces/http/middleware
+// @private true
+// @ast-trackable true
+func init() {
+	logger.RegisterContextKey(ctxutil.RequestIDKey, "request_id")
+}
+
+// RequestID creates a middleware that reads the X-Request-ID header or
+// generates a new one, then stores it in the request context and echoes it
+// back on the response header
+// @function RequestID
+// @package template/interfaces/http/middleware
+// @params log logger.Logger - Logger instance
+// @returns func(http.Handler) http.Handler - Middleware function
+// @middleware true
+// @http-middleware true
+// @ast-trackable true
+func RequestID(log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(RequestIDHeader)
+			generated := false
+			if id == "" {
+				id = generateRequestID()
+				generated = true
+			}
+
+			w.Header().Set(RequestIDHeader, id)
+			ctx := ctxutil.WithRequestID(r.Context(), id)
+
+			if generated {
+				log.DebugCtx(ctx, "HTTP_REQUEST_ID_GENERATED", nil, "Generated request ID")
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or an
+// empty string if the context carries none. It is a thin alias over
+// ctxutil.RequestID
+// @function RequestIDFromContext
+// @package template/interfaces/http/middleware
+// @params ctx context.Context - Request context
+// @returns string - Request ID, or empty string if absent
+// @ast-trackable true
+func RequestIDFromContext(ctx context.Context) string {
+	return ctxutil.RequestID(ctx)
+}
+
+// generateRequestID produces a random 32-character hex identifier
+// @function generateRequestID
+// @package template/interfaces/http/middleware
+// @returns string - Newly generated request ID
+// @private true
+// @ast-trackable true
+func generateRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "00000000000000000000000000000000"
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,52 @@
+// FILE: template/interfaces/http/middleware/response_writer.go
+
+package middleware
+
+import "net/http"
+
+// responseWriter wraps http.ResponseWriter to capture the status code and
+// byte count written by downstream handlers, for use by AccessLog
+// @struct responseWriter
+// @package template/interfaces/http/middleware
+// @fields ResponseWriter,statusCode,bytesWritten
+// @private true
+// @ast-trackable true
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode   int
+	bytesWritten int
+}
+
+// newResponseWriter creates a responseWriter defaulting statusCode to 200,
+// matching net/http's behavior when WriteHeader is never called explicitly
+// @constructor responseWriter
+// @package template/interfaces/http/middleware
+// @function newResponseWriter
+// @params w http.ResponseWriter - Underlying response writer to wrap
+// @returns *responseWriter - Wrapped writer
+// @private true
+// @ast-trackable true
+func newResponseWriter(w http.ResponseWriter) *responseWriter {
+	return &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+}
+
+// WriteHeader records the status code before delegating to the underlying writer
+// @method WriteHeader
+// @receiver *responseWriter
+// @package template/interfaces/http/middleware
+// @ast-trackable true
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.statusCode = statusCode
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write records bytes written before delegating to the underlying writer
+// @method Write
+// @receiver *responseWriter
+// @package template/interfaces/http/middleware
+// @ast-trackable true
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytesWritten += n
+	return n, err
+}
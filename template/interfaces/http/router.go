@@ -7,24 +7,34 @@ import (
 	"template/infrastructure/logger"
 	"template/interfaces/http/handlers"
 	"template/interfaces/http/middleware"
+	"template/interfaces/http/transport"
 )
 
 // NewRouter creates and configures the main HTTP router
 // @function NewRouter
 // @package template/interfaces/http
-// @params log *logger.PrettyLogger - Logger instance
+// @params log logger.Logger - Logger instance
 // @returns http.Handler - Configured HTTP handler
 // @router-factory true
 // @http-router true
 // @routes /test
 // @ast-trackable true
-func NewRouter(log *logger.PrettyLogger) http.Handler {
-	testHandler := &handlers.TestHandler{Logger: log}
+func NewRouter(log logger.Logger) http.Handler {
+	testService := &handlers.TestService{Logger: log}
 
 	mux := http.NewServeMux()
-	mux.Handle("/test", testHandler)
+	mux.Handle("/test", transport.NewServer(
+		handlers.MakePingEndpoint(testService),
+		handlers.DecodePingRequest,
+		handlers.EncodePingResponse,
+	))
 
-	handler := middleware.LoggingMiddleware(log)(mux)
+	chain := middleware.Chain(
+		middleware.RequestID(log),
+		middleware.Recovery(log),
+		middleware.CORS(middleware.DefaultCORSConfig()),
+		middleware.AccessLog(log),
+	)
 
-	return handler
+	return chain(mux)
 }
@@ -0,0 +1,196 @@
+// FILE: template/interfaces/http/transport/transport.go
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"template/interfaces/http/response"
+)
+
+// Endpoint is a transport-agnostic unit of business logic: a decoded
+// request in, a response (or error) out. Middleware such as logging,
+// metrics, or tracing can wrap an Endpoint independent of HTTP
+// @type Endpoint
+// @package template/interfaces/http/transport
+// @primitive func(context.Context, interface{}) (interface{}, error)
+// @ast-trackable true
+type Endpoint func(ctx context.Context, req interface{}) (interface{}, error)
+
+// DecodeRequestFunc extracts a request value from an inbound *http.Request
+// @type DecodeRequestFunc
+// @package template/interfaces/http/transport
+// @primitive func(context.Context, *http.Request) (interface{}, error)
+// @ast-trackable true
+type DecodeRequestFunc func(ctx context.Context, r *http.Request) (interface{}, error)
+
+// EncodeResponseFunc writes a successful Endpoint response to the ResponseWriter
+// @type EncodeResponseFunc
+// @package template/interfaces/http/transport
+// @primitive func(context.Context, http.ResponseWriter, interface{}) error
+// @ast-trackable true
+type EncodeResponseFunc func(ctx context.Context, w http.ResponseWriter, resp interface{}) error
+
+// ErrorEncoder writes an error from decoding or the Endpoint to the ResponseWriter
+// @type ErrorEncoder
+// @package template/interfaces/http/transport
+// @primitive func(context.Context, error, http.ResponseWriter)
+// @ast-trackable true
+type ErrorEncoder func(ctx context.Context, err error, w http.ResponseWriter)
+
+// Option configures a Server
+// @type Option
+// @package template/interfaces/http/transport
+// @primitive func(*Server)
+// @ast-trackable true
+type Option func(*Server)
+
+// WithErrorEncoder overrides the Server's default ErrorEncoder
+// @function WithErrorEncoder
+// @package template/interfaces/http/transport
+// @params ee ErrorEncoder - Custom error encoder
+// @returns Option - Server option
+// @ast-trackable true
+func WithErrorEncoder(ee ErrorEncoder) Option {
+	return func(s *Server) {
+		s.errorEncoder = ee
+	}
+}
+
+// Server is an http.Handler that runs decode -> endpoint -> encode for every
+// request, routing any error through its ErrorEncoder
+// @struct Server
+// @package template/interfaces/http/transport
+// @fields endpoint,decode,encode,errorEncoder
+// @implements http.Handler
+// @ast-trackable true
+type Server struct {
+	endpoint     Endpoint
+	decode       DecodeRequestFunc
+	encode       EncodeResponseFunc
+	errorEncoder ErrorEncoder
+}
+
+// NewServer builds an http.Handler that decodes the request, invokes
+// endpoint, and encodes the response, applying any options given
+// @constructor Server
+// @package template/interfaces/http/transport
+// @function NewServer
+// @params endpoint Endpoint - Business logic to invoke
+// @params decode DecodeRequestFunc - Builds the endpoint request from *http.Request
+// @params encode EncodeResponseFunc - Writes the endpoint response
+// @params opts ...Option - Optional Server configuration
+// @returns http.Handler - Handler wiring decode -> endpoint -> encode
+// @usage mux.Handle("/test", transport.NewServer(endpoint, decode, encode))
+// @ast-trackable true
+// @factory-function true
+func NewServer(endpoint Endpoint, decode DecodeRequestFunc, encode EncodeResponseFunc, opts ...Option) http.Handler {
+	s := &Server{
+		endpoint:     endpoint,
+		decode:       decode,
+		encode:       encode,
+		errorEncoder: defaultErrorEncoder,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// ServeHTTP implements http.Handler by running decode -> endpoint -> encode
+// @method ServeHTTP
+// @receiver *Server
+// @package template/interfaces/http/transport
+// @ast-trackable true
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := s.decode(ctx, r)
+	if err != nil {
+		s.errorEncoder(ctx, err, w)
+		return
+	}
+
+	resp, err := s.endpoint(ctx, req)
+	if err != nil {
+		s.errorEncoder(ctx, err, w)
+		return
+	}
+
+	if err := s.encode(ctx, w, resp); err != nil {
+		s.errorEncoder(ctx, err, w)
+	}
+}
+
+// StatusCoder is implemented by errors that know which HTTP status they
+// should be reported as (e.g. a decode error for a malformed request should
+// report 400, not the default 500). defaultErrorEncoder type-switches on
+// this so decode/endpoint errors can opt into their own status
+// @interface StatusCoder
+// @package template/interfaces/http/transport
+// @method StatusCode() int
+// @ast-trackable true
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// HTTPError is a StatusCoder error for DecodeRequestFunc/Endpoint
+// implementations that want to report a specific status (typically 400 for
+// a malformed request) instead of falling back to the default 500
+// @struct HTTPError
+// @package template/interfaces/http/transport
+// @implements error,StatusCoder
+// @fields code,message
+// @ast-trackable true
+type HTTPError struct {
+	code    int
+	message string
+}
+
+// NewHTTPError builds an HTTPError reporting code with message
+// @constructor HTTPError
+// @package template/interfaces/http/transport
+// @function NewHTTPError
+// @params code int - HTTP status code to report
+// @params message string - Error message
+// @returns *HTTPError - Error carrying the given status
+// @usage return nil, transport.NewHTTPError(http.StatusBadRequest, "missing id")
+// @ast-trackable true
+// @factory-function true
+func NewHTTPError(code int, message string) *HTTPError {
+	return &HTTPError{code: code, message: message}
+}
+
+// Error implements the error interface
+// @method Error
+// @receiver *HTTPError
+// @package template/interfaces/http/transport
+// @ast-trackable true
+func (e *HTTPError) Error() string {
+	return e.message
+}
+
+// StatusCode implements StatusCoder
+// @method StatusCode
+// @receiver *HTTPError
+// @package template/interfaces/http/transport
+// @ast-trackable true
+func (e *HTTPError) StatusCode() int {
+	return e.code
+}
+
+// defaultErrorEncoder writes err via response.Error, using err's StatusCode()
+// if it implements StatusCoder and falling back to 500 otherwise
+// @function defaultErrorEncoder
+// @package template/interfaces/http/transport
+// @private true
+// @ast-trackable true
+func defaultErrorEncoder(_ context.Context, err error, w http.ResponseWriter) {
+	statusCode := http.StatusInternalServerError
+	if sc, ok := err.(StatusCoder); ok {
+		statusCode = sc.StatusCode()
+	}
+	response.Error(w, statusCode, err.Error())
+}
@@ -0,0 +1,106 @@
+// FILE: template/pkg/ctxutil/ctxutil.go
+
+// Package ctxutil provides typed helpers for the request-scoped values
+// (request ID, user, deadline) that flow from HTTP middleware down through
+// handlers, services, and logging
+// @package template/pkg/ctxutil
+package ctxutil
+
+import (
+	"context"
+	"time"
+)
+
+// contextKey is a private type for ctxutil's context keys, avoiding
+// collisions with keys set by other packages
+// @type contextKey
+// @package template/pkg/ctxutil
+// @primitive string
+// @private true
+// @ast-trackable true
+type contextKey string
+
+// RequestIDKey is the context.Context key request IDs are stored under
+// @const RequestIDKey
+// @package template/pkg/ctxutil
+// @type contextKey
+// @ast-trackable true
+const RequestIDKey contextKey = "request_id"
+
+// UserKey is the context.Context key the authenticated user is stored under
+// @const UserKey
+// @package template/pkg/ctxutil
+// @type contextKey
+// @ast-trackable true
+const UserKey contextKey = "user"
+
+// WithRequestID returns a copy of ctx carrying id as the request ID
+// @function WithRequestID
+// @package template/pkg/ctxutil
+// @params ctx context.Context - Parent context
+// @params id string - Request ID to attach
+// @returns context.Context - Context carrying id
+// @ast-trackable true
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, RequestIDKey, id)
+}
+
+// RequestID returns the request ID stored on ctx, or an empty string if
+// ctx carries none
+// @function RequestID
+// @package template/pkg/ctxutil
+// @params ctx context.Context - Context to read from
+// @returns string - Request ID, or empty string if absent
+// @ast-trackable true
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(RequestIDKey).(string)
+	return id
+}
+
+// WithUser returns a copy of ctx carrying user as the authenticated user
+// @function WithUser
+// @package template/pkg/ctxutil
+// @params ctx context.Context - Parent context
+// @params user string - User identifier to attach
+// @returns context.Context - Context carrying user
+// @ast-trackable true
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, UserKey, user)
+}
+
+// User returns the user stored on ctx, or an empty string if ctx carries none
+// @function User
+// @package template/pkg/ctxutil
+// @params ctx context.Context - Context to read from
+// @returns string - User identifier, or empty string if absent
+// @ast-trackable true
+func User(ctx context.Context) string {
+	user, _ := ctx.Value(UserKey).(string)
+	return user
+}
+
+// WithDeadline is a thin wrapper over context.WithDeadline, kept alongside
+// WithRequestID/WithUser so callers have one package to reach for every
+// request-scoped concern
+// @function WithDeadline
+// @package template/pkg/ctxutil
+// @params ctx context.Context - Parent context
+// @params d time.Time - Deadline to attach
+// @returns context.Context - Context that is canceled at d
+// @returns context.CancelFunc - Cancels the returned context early
+// @ast-trackable true
+func WithDeadline(ctx context.Context, d time.Time) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, d)
+}
+
+// Deadline is a thin wrapper over ctx.Deadline, kept alongside RequestID/User
+// so callers have one package to reach for every request-scoped concern
+// @function Deadline
+// @package template/pkg/ctxutil
+// @params ctx context.Context - Context to read from
+// @returns time.Time - Deadline, if any
+// @returns bool - Whether a deadline is set
+// @ast-trackable true
+func Deadline(ctx context.Context) (time.Time, bool) {
+	return ctx.Deadline()
+}